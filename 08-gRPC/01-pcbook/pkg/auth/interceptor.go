@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthInterceptor intercepts unary/stream gRPC calls to check the caller's JWT access token
+// against a per-method map of roles allowed to call it
+type AuthInterceptor struct {
+	jwtManager      *JWTManager
+	accessibleRoles map[string][]string
+}
+
+// NewAuthInterceptor returns a new AuthInterceptor. accessibleRoles maps a full RPC method
+// name (e.g. "/techschool.pcbook.LaptopService/CreateLaptop") to the roles allowed to call it;
+// a method with no entry is open to any authenticated or unauthenticated caller.
+func NewAuthInterceptor(jwtManager *JWTManager, accessibleRoles map[string][]string) *AuthInterceptor {
+	return &AuthInterceptor{jwtManager: jwtManager, accessibleRoles: accessibleRoles}
+}
+
+// Unary returns a server interceptor function to authenticate and authorize unary RPCs
+func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := interceptor.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns a server interceptor function to authenticate and authorize stream RPCs
+func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := interceptor.authorize(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// authorize checks whether the caller's access token grants it one of the roles allowed to
+// call method, returning Unauthenticated if the token is missing/invalid and PermissionDenied
+// if the caller's role isn't in the allowed list.
+func (interceptor *AuthInterceptor) authorize(ctx context.Context, method string) error {
+	accessibleRoles, ok := interceptor.accessibleRoles[method]
+	if !ok {
+		// everyone can access methods with no role restriction
+		return nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	values := md["authorization"]
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+
+	const bearerPrefix = "bearer "
+	accessToken := values[0]
+	if len(accessToken) >= len(bearerPrefix) && strings.EqualFold(accessToken[:len(bearerPrefix)], bearerPrefix) {
+		accessToken = accessToken[len(bearerPrefix):]
+	}
+	claims, err := interceptor.jwtManager.Verify(accessToken)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+	}
+
+	for _, role := range accessibleRoles {
+		if role == claims.Role {
+			return nil
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, "no permission to access this RPC")
+}
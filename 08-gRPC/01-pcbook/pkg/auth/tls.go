@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// LoadServerTLSCredentials loads the server's own certificate/key pair and returns transport
+// credentials for a TLS listener. If clientCAFile is non-empty, the server additionally
+// requires and verifies a client certificate signed by that CA (mutual TLS).
+func LoadServerTLSCredentials(certFile, keyFile, clientCAFile string) (credentials.TransportCredentials, error) {
+	serverCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load server key pair: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.NoClientCert,
+	}
+
+	if clientCAFile != "" {
+		pemClientCA, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read client CA file: %w", err)
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(pemClientCA) {
+			return nil, fmt.Errorf("cannot add client CA's certificate")
+		}
+
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = certPool
+	}
+
+	return credentials.NewTLS(config), nil
+}
+
+// LoadClientTLSCredentials loads the CA certificate used to verify the server, and returns
+// transport credentials for dialing a TLS server. If clientCertFile and clientKeyFile are
+// both non-empty, the client additionally presents its own certificate (mutual TLS).
+func LoadClientTLSCredentials(caFile, clientCertFile, clientKeyFile string) (credentials.TransportCredentials, error) {
+	pemServerCA, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read server CA file: %w", err)
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pemServerCA) {
+		return nil, fmt.Errorf("cannot add server CA's certificate")
+	}
+
+	config := &tls.Config{
+		RootCAs: certPool,
+	}
+
+	if clientCertFile != "" && clientKeyFile != "" {
+		clientCert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load client key pair: %w", err)
+		}
+		config.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return credentials.NewTLS(config), nil
+}
@@ -0,0 +1,130 @@
+package sample
+
+import (
+	"math/rand"
+
+	"github.com/google/uuid"
+
+	"pcbook/pb"
+)
+
+// NewKeyboard returns a new sample keyboard
+func NewKeyboard() *pb.Keyboard {
+	return &pb.Keyboard{
+		Layout:  randomKeyboardLayout(),
+		Backlit: randomBool(),
+	}
+}
+
+// NewCPU returns a new sample CPU
+func NewCPU() *pb.CPU {
+	brand := randomCPUBrand()
+	name := randomCPUName(brand)
+
+	numberCores := randomInt(2, 8)
+	numberThreads := randomInt(numberCores, 12)
+
+	minGhz := randomFloat64(2.0, 3.5)
+	maxGhz := randomFloat64(minGhz, 5.0)
+
+	return &pb.CPU{
+		Brand:         brand,
+		Name:          name,
+		NumberCores:   uint32(numberCores),
+		NumberThreads: uint32(numberThreads),
+		MinGhz:        minGhz,
+		MaxGhz:        maxGhz,
+	}
+}
+
+// NewGPU returns a new sample GPU
+func NewGPU() *pb.GPU {
+	brand := randomGPUBrand()
+	name := randomGPUName(brand)
+
+	minGhz := randomFloat64(1.0, 1.5)
+	maxGhz := randomFloat64(minGhz, 2.0)
+
+	memory := &pb.Memory{
+		Value: uint64(randomInt(2, 6)),
+		Unit:  pb.Memory_GIGABYTE,
+	}
+
+	return &pb.GPU{
+		Brand:  brand,
+		Name:   name,
+		MinGhz: minGhz,
+		MaxGhz: maxGhz,
+		Memory: memory,
+	}
+}
+
+// NewRAM returns a new sample RAM
+func NewRAM() *pb.Memory {
+	return &pb.Memory{
+		Value: uint64(randomInt(4, 64)),
+		Unit:  pb.Memory_GIGABYTE,
+	}
+}
+
+// NewSSD returns a new sample SSD storage
+func NewSSD() *pb.Storage {
+	return &pb.Storage{
+		Driver: pb.Storage_SSD,
+		Memory: &pb.Memory{
+			Value: uint64(randomInt(128, 1024)),
+			Unit:  pb.Memory_GIGABYTE,
+		},
+	}
+}
+
+// NewHDD returns a new sample HDD storage
+func NewHDD() *pb.Storage {
+	return &pb.Storage{
+		Driver: pb.Storage_HDD,
+		Memory: &pb.Memory{
+			Value: uint64(randomInt(1, 6)),
+			Unit:  pb.Memory_TERABYTE,
+		},
+	}
+}
+
+// NewScreen returns a new sample screen
+func NewScreen() *pb.Screen {
+	return &pb.Screen{
+		SizeInch: randomFloat32(13, 17),
+		Resolution: &pb.Screen_Resolution{
+			Width:  uint32(randomInt(1920, 3840)),
+			Height: uint32(randomInt(1080, 2160)),
+		},
+		Panel:      randomScreenPanel(),
+		Multitouch: randomBool(),
+	}
+}
+
+// NewLaptop returns a new sample laptop
+func NewLaptop() *pb.Laptop {
+	brand := randomLaptopBrand()
+
+	laptop := &pb.Laptop{
+		Id:          uuid.New().String(),
+		Brand:       brand,
+		Name:        randomLaptopName(brand),
+		Cpu:         NewCPU(),
+		Ram:         NewRAM(),
+		Gpus:        []*pb.GPU{NewGPU()},
+		Storages:    []*pb.Storage{NewSSD(), NewHDD()},
+		Screen:      NewScreen(),
+		Keyboard:    NewKeyboard(),
+		PriceUsd:    randomFloat64(1500, 4500),
+		ReleaseYear: uint32(randomInt(2015, 2021)),
+	}
+
+	if rand.Intn(2) == 1 {
+		laptop.Weight = &pb.Laptop_WeightKg{WeightKg: randomFloat64(1.0, 3.0)}
+	} else {
+		laptop.Weight = &pb.Laptop_WeightLb{WeightLb: randomFloat64(2.0, 6.0)}
+	}
+
+	return laptop
+}
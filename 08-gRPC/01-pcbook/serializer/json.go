@@ -0,0 +1,25 @@
+package serializer
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// ProtobufToJSON converts a protobuf message to a JSON string
+func ProtobufToJSON(message proto.Message) (string, error) {
+	marshaler := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "  ",
+		OrigName:     true,
+	}
+
+	return marshaler.MarshalToString(message)
+}
+
+// JSONToProtobuf parses a JSON string produced by ProtobufToJSON back into message
+func JSONToProtobuf(data string, message proto.Message) error {
+	return jsonpb.Unmarshal(strings.NewReader(data), message)
+}
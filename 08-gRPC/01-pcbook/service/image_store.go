@@ -0,0 +1,237 @@
+package service
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// ErrImageNotFound is returned when an image ID does not exist in the store
+var ErrImageNotFound = errors.New("image not found")
+
+// ErrChecksumMismatch is returned when the uploaded bytes don't match the advertised SHA-256
+var ErrChecksumMismatch = errors.New("uploaded data does not match the advertised sha256 checksum")
+
+// ErrSizeMismatch is returned when the number of bytes written doesn't match the advertised total size
+var ErrSizeMismatch = errors.New("bytes received does not match the advertised total size")
+
+// ImageStore is an interface to store laptop images
+type ImageStore interface {
+	// Create starts a new upload for laptopID and returns the generated image ID
+	Create(laptopID string, imageType string, totalSize uint64, sha256 string) (string, error)
+	// Write appends chunk at offset and returns the new total number of bytes written
+	Write(imageID string, offset uint64, chunk []byte) (uint64, error)
+	// Complete verifies that the full image matches its advertised size and checksum
+	Complete(imageID string) error
+	// BytesReceived returns how many bytes have been written so far for a resumable upload
+	BytesReceived(imageID string) (uint64, error)
+}
+
+// imageRecord holds the bookkeeping for a single in-progress or completed upload
+type imageRecord struct {
+	LaptopID  string
+	Type      string
+	TotalSize uint64
+	SHA256    string
+	Written   uint64
+}
+
+// InMemoryImageStore stores image data in memory, keyed by image ID
+type InMemoryImageStore struct {
+	mutex sync.RWMutex
+	data  map[string]*imageRecord
+	bytes map[string]*bytes.Buffer
+}
+
+// NewInMemoryImageStore returns a new InMemoryImageStore
+func NewInMemoryImageStore() *InMemoryImageStore {
+	return &InMemoryImageStore{
+		data:  make(map[string]*imageRecord),
+		bytes: make(map[string]*bytes.Buffer),
+	}
+}
+
+// Create starts a new upload for laptopID and returns the generated image ID
+func (store *InMemoryImageStore) Create(laptopID string, imageType string, totalSize uint64, sha256 string) (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	imageID, err := uuid.NewRandom()
+	if err != nil {
+		return "", fmt.Errorf("cannot generate image ID: %w", err)
+	}
+
+	id := imageID.String()
+	store.data[id] = &imageRecord{LaptopID: laptopID, Type: imageType, TotalSize: totalSize, SHA256: sha256}
+	store.bytes[id] = new(bytes.Buffer)
+	return id, nil
+}
+
+// Write appends chunk at offset and returns the new total number of bytes written
+func (store *InMemoryImageStore) Write(imageID string, offset uint64, chunk []byte) (uint64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	record, ok := store.data[imageID]
+	if !ok {
+		return 0, ErrImageNotFound
+	}
+
+	if offset != uint64(store.bytes[imageID].Len()) {
+		return 0, fmt.Errorf("offset %d does not match %d bytes already received", offset, store.bytes[imageID].Len())
+	}
+
+	store.bytes[imageID].Write(chunk)
+	record.Written = uint64(store.bytes[imageID].Len())
+	return record.Written, nil
+}
+
+// Complete verifies that the full image matches its advertised size and checksum
+func (store *InMemoryImageStore) Complete(imageID string) error {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	record, ok := store.data[imageID]
+	if !ok {
+		return ErrImageNotFound
+	}
+
+	if record.Written != record.TotalSize {
+		return ErrSizeMismatch
+	}
+
+	sum := sha256.Sum256(store.bytes[imageID].Bytes())
+	if hex.EncodeToString(sum[:]) != record.SHA256 {
+		return ErrChecksumMismatch
+	}
+
+	return nil
+}
+
+// BytesReceived returns how many bytes have been written so far for a resumable upload
+func (store *InMemoryImageStore) BytesReceived(imageID string) (uint64, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	record, ok := store.data[imageID]
+	if !ok {
+		return 0, ErrImageNotFound
+	}
+
+	return record.Written, nil
+}
+
+// DiskImageStore stores image files on disk under ImageFolder, named <image-id><extension>
+type DiskImageStore struct {
+	mutex       sync.RWMutex
+	imageFolder string
+	data        map[string]*imageRecord
+}
+
+// NewDiskImageStore returns a new DiskImageStore rooted at imageFolder
+func NewDiskImageStore(imageFolder string) *DiskImageStore {
+	return &DiskImageStore{
+		imageFolder: imageFolder,
+		data:        make(map[string]*imageRecord),
+	}
+}
+
+func (store *DiskImageStore) path(imageID string) string {
+	return filepath.Join(store.imageFolder, imageID)
+}
+
+// Create starts a new upload for laptopID and returns the generated image ID
+func (store *DiskImageStore) Create(laptopID string, imageType string, totalSize uint64, sha256 string) (string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	imageID, err := uuid.NewRandom()
+	if err != nil {
+		return "", fmt.Errorf("cannot generate image ID: %w", err)
+	}
+
+	id := imageID.String()
+	file, err := os.Create(store.path(id))
+	if err != nil {
+		return "", fmt.Errorf("cannot create image file: %w", err)
+	}
+	file.Close()
+
+	store.data[id] = &imageRecord{LaptopID: laptopID, Type: imageType, TotalSize: totalSize, SHA256: sha256}
+	return id, nil
+}
+
+// Write appends chunk at offset and returns the new total number of bytes written
+func (store *DiskImageStore) Write(imageID string, offset uint64, chunk []byte) (uint64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	record, ok := store.data[imageID]
+	if !ok {
+		return 0, ErrImageNotFound
+	}
+
+	if offset != record.Written {
+		return 0, fmt.Errorf("offset %d does not match %d bytes already received", offset, record.Written)
+	}
+
+	file, err := os.OpenFile(store.path(imageID), os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, fmt.Errorf("cannot open image file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteAt(chunk, int64(offset)); err != nil {
+		return 0, fmt.Errorf("cannot write image chunk: %w", err)
+	}
+
+	record.Written += uint64(len(chunk))
+	return record.Written, nil
+}
+
+// Complete verifies that the full image matches its advertised size and checksum
+func (store *DiskImageStore) Complete(imageID string) error {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	record, ok := store.data[imageID]
+	if !ok {
+		return ErrImageNotFound
+	}
+
+	if record.Written != record.TotalSize {
+		return ErrSizeMismatch
+	}
+
+	content, err := os.ReadFile(store.path(imageID))
+	if err != nil {
+		return fmt.Errorf("cannot read image file: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+	if hex.EncodeToString(sum[:]) != record.SHA256 {
+		return ErrChecksumMismatch
+	}
+
+	return nil
+}
+
+// BytesReceived returns how many bytes have been written so far for a resumable upload
+func (store *DiskImageStore) BytesReceived(imageID string) (uint64, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	record, ok := store.data[imageID]
+	if !ok {
+		return 0, ErrImageNotFound
+	}
+
+	return record.Written, nil
+}
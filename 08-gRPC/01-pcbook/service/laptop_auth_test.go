@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"pcbook/pb"
+	"pcbook/pkg/auth"
+	"pcbook/sample"
+	"pcbook/service"
+)
+
+// generateSelfSignedCert creates a self-signed certificate/key pair valid for the given IP
+// addresses and writes them as PEM files under t.TempDir(), returning their paths. The cert
+// doubles as its own CA, since that's all a test TLS listener needs to be trusted by a client.
+func generateSelfSignedCert(t *testing.T, ips ...string) (certFile, keyFile string) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "pcbook test server"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	for _, ip := range ips {
+		template.IPAddresses = append(template.IPAddresses, net.ParseIP(ip))
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+
+	certOut, err := os.Create(certFile)
+	require.NoError(t, err)
+	require.NoError(t, pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	require.NoError(t, certOut.Close())
+
+	keyOut, err := os.Create(keyFile)
+	require.NoError(t, err)
+	require.NoError(t, pem.Encode(keyOut, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv)}))
+	require.NoError(t, keyOut.Close())
+
+	return certFile, keyFile
+}
+
+func TestLaptopServerAuth(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := generateSelfSignedCert(t, "127.0.0.1")
+
+	serverCreds, err := auth.LoadServerTLSCredentials(certFile, keyFile, "")
+	require.NoError(t, err)
+
+	clientCreds, err := auth.LoadClientTLSCredentials(certFile, "", "")
+	require.NoError(t, err)
+
+	jwtManager := auth.NewJWTManager("test-secret", 15*time.Minute)
+	accessibleRoles := map[string][]string{
+		pb.LaptopService_CreateLaptop_FullMethodName: {"admin"},
+	}
+	interceptor := auth.NewAuthInterceptor(jwtManager, accessibleRoles)
+
+	grpcServer := service.NewLaptopServerTLS(service.NewInMemoryLaptopStore(), serverCreds, interceptor)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(clientCreds))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewLaptopServiceClient(conn)
+
+	createLaptop := func(ctx context.Context) error {
+		_, err := client.CreateLaptop(ctx, &pb.CreateLaptopRequest{Laptop: sample.NewLaptop()})
+		return err
+	}
+
+	contextWithToken := func(t *testing.T, username, role string) context.Context {
+		token, err := jwtManager.Generate(username, role)
+		require.NoError(t, err)
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "bearer "+token)
+	}
+
+	t.Run("no token", func(t *testing.T) {
+		err := createLaptop(context.Background())
+		require.Error(t, err)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("wrong role", func(t *testing.T) {
+		err := createLaptop(contextWithToken(t, "alice", "user"))
+		require.Error(t, err)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("valid admin token", func(t *testing.T) {
+		err := createLaptop(contextWithToken(t, "admin", "admin"))
+		require.NoError(t, err)
+	})
+
+	t.Run("open method requires no token", func(t *testing.T) {
+		stream, err := client.SearchLaptop(context.Background(), &pb.SearchLaptopRequest{Filter: &pb.Filter{}})
+		require.NoError(t, err)
+
+		// the terminal response (no more pages) is still expected before EOF
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		require.Nil(t, res.GetLaptop())
+
+		_, err = stream.Recv()
+		require.Equal(t, io.EOF, err)
+	})
+}
@@ -2,8 +2,11 @@ package service_test
 
 import (
 	"context"
+	"database/sql"
 	"io"
 	"net"
+	"os"
+	"path/filepath"
 	"pcbook/pb"
 	"pcbook/sample"
 	"pcbook/serializer"
@@ -14,36 +17,83 @@ import (
 	"google.golang.org/grpc"
 )
 
-func TestClientCreateLaptop(t *testing.T) {
-	t.Parallel()
-
-	laptopServer, serverAddress := startTestLaptopServer(t, service.NewInMemoryLaptopStore())
-	laptopClient := newTestLaptopClient(t, serverAddress)
+// laptopStoreFactories maps a backend name to a constructor used to parameterize tests that
+// must pass against every LaptopStore implementation. Postgres is only included when PGURL is
+// set, since it requires a running database.
+func laptopStoreFactories(t *testing.T) map[string]func() service.LaptopStore {
+	factories := map[string]func() service.LaptopStore{
+		"in-memory": func() service.LaptopStore {
+			return service.NewInMemoryLaptopStore()
+		},
+		"bolt": func() service.LaptopStore {
+			dbPath := filepath.Join(t.TempDir(), "laptop.db")
+			store, err := service.NewBoltLaptopStore(dbPath)
+			require.NoError(t, err)
+			t.Cleanup(func() { store.Close() })
+			return store
+		},
+	}
 
-	laptop := sample.NewLaptop()
-	expectedID := laptop.Id
-	req := &pb.CreateLaptopRequest{
-		Laptop: laptop,
+	if pgURL := os.Getenv("PGURL"); pgURL != "" {
+		factories["postgres"] = func() service.LaptopStore {
+			db, err := sql.Open("postgres", pgURL)
+			require.NoError(t, err)
+			t.Cleanup(func() { db.Close() })
+
+			store := service.NewPostgresLaptopStore(db)
+			require.NoError(t, store.Migrate(context.Background()))
+			// start each test from an empty table so filter-based assertions aren't
+			// thrown off by laptops left over from a previous run
+			_, err = db.Exec(`TRUNCATE TABLE laptops`)
+			require.NoError(t, err)
+			return store
+		}
 	}
 
-	res, err := laptopClient.CreateLaptop(context.Background(), req)
-	require.NoError(t, err)
-	require.NotNil(t, res)
-	require.Equal(t, expectedID, res.Id)
+	return factories
+}
 
-	// check that the laptop is saved to the store
-	other, err := laptopServer.Store.Find(res.Id)
-	require.NoError(t, err)
-	require.NotNil(t, other)
+func TestClientCreateLaptop(t *testing.T) {
+	t.Parallel()
 
-	// check that the saved laptop is the same as the one we send
-	requireSameLaptop(t, laptop, other)
+	for name, newStore := range laptopStoreFactories(t) {
+		newStore := newStore
+		t.Run(name, func(t *testing.T) {
+			store := newStore()
+			laptopServer, serverAddress := startTestLaptopServer(t, store)
+			laptopClient := newTestLaptopClient(t, serverAddress)
+
+			laptop := sample.NewLaptop()
+			expectedID := laptop.Id
+			req := &pb.CreateLaptopRequest{
+				Laptop: laptop,
+			}
+
+			res, err := laptopClient.CreateLaptop(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Equal(t, expectedID, res.Id)
+
+			// check that the laptop is saved to the store
+			other, err := laptopServer.Store.Find(res.Id)
+			require.NoError(t, err)
+			require.NotNil(t, other)
+
+			// check that the saved laptop is the same as the one we send
+			requireSameLaptop(t, laptop, other)
+		})
+	}
 }
 
-//create a new laptop server with an in-memory laptop store
+// create a new laptop server with an in-memory laptop store and an in-memory image store
 func startTestLaptopServer(t *testing.T, store service.LaptopStore) (*service.LaptopServer, string) {
-	laptopServer := service.NewLaptopServer(store)
+	laptopServer := service.NewLaptopServer(store, service.NewInMemoryImageStore(), service.NewInMemoryRatingStore())
+	return laptopServer, serveLaptopServer(t, laptopServer)
+}
 
+// serveLaptopServer registers an already-built LaptopServer on a gRPC server listening on a
+// random local port, and returns that port's address.
+func serveLaptopServer(t *testing.T, laptopServer *service.LaptopServer) string {
 	//We create the gRPC server by calling grpc.NewServer() function, then register the laptop service server on that gRPC server.
 	grpcServer := grpc.NewServer()
 	pb.RegisterLaptopServiceServer(grpcServer, laptopServer)
@@ -55,10 +105,10 @@ func startTestLaptopServer(t *testing.T, store service.LaptopStore) (*service.La
 
 	go grpcServer.Serve(listener)
 
-	return laptopServer, listener.Addr().String()
+	return listener.Addr().String()
 }
 
-//return a new laptop-client
+// return a new laptop-client
 func newTestLaptopClient(t *testing.T, serverAddress string) pb.LaptopServiceClient {
 
 	//First we dial the server address with grpc.Dial(). Since this is just for testing, we use an insecure connection.
@@ -70,81 +120,93 @@ func newTestLaptopClient(t *testing.T, serverAddress string) pb.LaptopServiceCli
 func TestClientSearchLaptop(t *testing.T) {
 	t.Parallel()
 
-	//First I will create a search filter and an in-memory laptop store to insert some laptops for searching
-	filter := &pb.Filter{
-		MaxPriceUsd: 2000,
-		MinCpuCores: 4,
-		MinCpuGhz:   2.2,
-		MinRam:      &pb.Memory{Value: 8, Unit: pb.Memory_GIGABYTE},
-	}
-
-	store := service.NewInMemoryLaptopStore()
-
-	//Then I make an expectedIDs map that will contain all laptop IDs that we expect to be found by the server, Case 4 + 5: matched.
-	expectedIDs := make(map[string]bool)
-
-	for i := 0; i < 6; i++ {
-		laptop := sample.NewLaptop()
-
-		switch i {
-		case 0:
-			laptop.PriceUsd = 2500
-		case 1:
-			laptop.Cpu.NumberCores = 2
-		case 2:
-			laptop.Cpu.MinGhz = 2.0
-		case 3:
-			laptop.Ram = &pb.Memory{Value: 4096, Unit: pb.Memory_MEGABYTE}
-		case 4:
-			laptop.PriceUsd = 1999
-			laptop.Cpu.NumberCores = 4
-			laptop.Cpu.MinGhz = 2.5
-			laptop.Cpu.MaxGhz = laptop.Cpu.MinGhz + 2.0
-			laptop.Ram = &pb.Memory{Value: 16, Unit: pb.Memory_GIGABYTE}
-			expectedIDs[laptop.Id] = true
-		case 5:
-			laptop.PriceUsd = 2000
-			laptop.Cpu.NumberCores = 6
-			laptop.Cpu.MinGhz = 2.8
-			laptop.Cpu.MaxGhz = laptop.Cpu.MinGhz + 2.0
-			laptop.Ram = &pb.Memory{Value: 64, Unit: pb.Memory_GIGABYTE}
-			expectedIDs[laptop.Id] = true
-		}
-
-		err := store.Save(laptop)
-		require.NoError(t, err)
+	for name, newStore := range laptopStoreFactories(t) {
+		newStore := newStore
+		t.Run(name, func(t *testing.T) {
+			//First I will create a search filter and a laptop store to insert some laptops for searching
+			filter := &pb.Filter{
+				MaxPriceUsd: 2000,
+				MinCpuCores: 4,
+				MinCpuGhz:   2.2,
+				MinRam:      &pb.Memory{Value: 8, Unit: pb.Memory_GIGABYTE},
+			}
+
+			store := newStore()
+
+			//Then I make an expectedIDs map that will contain all laptop IDs that we expect to be found by the server, Case 4 + 5: matched.
+			expectedIDs := make(map[string]bool)
+
+			for i := 0; i < 6; i++ {
+				laptop := sample.NewLaptop()
+
+				switch i {
+				case 0:
+					laptop.PriceUsd = 2500
+				case 1:
+					laptop.Cpu.NumberCores = 2
+				case 2:
+					laptop.Cpu.MinGhz = 2.0
+				case 3:
+					laptop.Ram = &pb.Memory{Value: 4096, Unit: pb.Memory_MEGABYTE}
+				case 4:
+					laptop.PriceUsd = 1999
+					laptop.Cpu.NumberCores = 4
+					laptop.Cpu.MinGhz = 2.5
+					laptop.Cpu.MaxGhz = laptop.Cpu.MinGhz + 2.0
+					laptop.Ram = &pb.Memory{Value: 16, Unit: pb.Memory_GIGABYTE}
+					expectedIDs[laptop.Id] = true
+				case 5:
+					laptop.PriceUsd = 2000
+					laptop.Cpu.NumberCores = 6
+					laptop.Cpu.MinGhz = 2.8
+					laptop.Cpu.MaxGhz = laptop.Cpu.MinGhz + 2.0
+					laptop.Ram = &pb.Memory{Value: 64, Unit: pb.Memory_GIGABYTE}
+					expectedIDs[laptop.Id] = true
+				}
+
+				err := store.Save(laptop)
+				require.NoError(t, err)
+			}
+
+			//Then call this function to start the test server, and create a laptop client object with that server address
+			_, serverAddress := startTestLaptopServer(t, store)
+			laptopClient := newTestLaptopClient(t, serverAddress)
+
+			//After that, we create a new SearchLaptopRequest with the filter
+			req := &pb.SearchLaptopRequest{Filter: filter}
+			//Then we call laptopCient.SearchLaptop() with the created request to get back the stream. There should be no errors returned
+			stream, err := laptopClient.SearchLaptop(context.Background(), req)
+			require.NoError(t, err)
+
+			//Next, I will use the found variable to keep track of the number of laptops found
+			found := 0
+			//Then use a for loop to receive multiple responses from the stream.
+			for {
+				res, err := stream.Recv()
+				//If we got an end-of-file error, then break.
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+
+				// the terminal response carries next_page_token instead of a laptop and marks
+				// the end of this page; there's only one page here, so it also ends the stream
+				if res.GetLaptop() == nil {
+					require.Empty(t, res.GetNextPageToken())
+					continue
+				}
+
+				//Else we check that the laptop ID should be in the expectedIDs map.
+				require.Contains(t, expectedIDs, res.GetLaptop().GetId())
+
+				//Then we increase the number of laptops found
+				found += 1
+			}
+
+			//Finally we require that number to equal to the size of the expectedIDs.
+			require.Equal(t, len(expectedIDs), found)
+		})
 	}
-
-	//Then call this function to start the test server, and create a laptop client object with that server address
-	_, serverAddress := startTestLaptopServer(t, store)
-	laptopClient := newTestLaptopClient(t, serverAddress)
-
-	//After that, we create a new SearchLaptopRequest with the filter
-	req := &pb.SearchLaptopRequest{Filter: filter}
-	//Then we call laptopCient.SearchLaptop() with the created request to get back the stream. There should be no errors returned
-	stream, err := laptopClient.SearchLaptop(context.Background(), req)
-	require.NoError(t, err)
-
-	//Next, I will use the found variable to keep track of the number of laptops found
-	found := 0
-	//Then use a for loop to receive multiple responses from the stream.
-	for {
-		res, err := stream.Recv()
-		//If we got an end-of-file error, then break.
-		if err == io.EOF {
-			break
-		}
-
-		//Else we check that there’s no error, and the laptop ID should be in the expectedIDs map.
-		require.NoError(t, err)
-		require.Contains(t, expectedIDs, res.GetLaptop().GetId())
-
-		//Then we increase the number of laptops found
-		found += 1
-	}
-
-	//Finally we require that number to equal to the size of the expectedIDs.
-	require.Equal(t, len(expectedIDs), found)
 }
 
 func requireSameLaptop(t *testing.T, laptop1 *pb.Laptop, laptop2 *pb.Laptop) {
@@ -155,4 +217,4 @@ func requireSameLaptop(t *testing.T, laptop1 *pb.Laptop, laptop2 *pb.Laptop) {
 	require.NoError(t, err)
 
 	require.Equal(t, json1, json2)
-}
\ No newline at end of file
+}
@@ -0,0 +1,87 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pcbook/pb"
+	"pcbook/sample"
+	"pcbook/service"
+)
+
+func TestClientRateLaptop(t *testing.T) {
+	t.Parallel()
+
+	store := service.NewInMemoryLaptopStore()
+	laptopServer := service.NewLaptopServer(store, service.NewInMemoryImageStore(), service.NewInMemoryRatingStore())
+	serverAddress := serveLaptopServer(t, laptopServer)
+	laptopClient := newTestLaptopClient(t, serverAddress)
+
+	const numLaptops = 2
+	laptopIDs := make([]string, numLaptops)
+	for i := 0; i < numLaptops; i++ {
+		laptop := sample.NewLaptop()
+		require.NoError(t, store.Save(laptop))
+		laptopIDs[i] = laptop.GetId()
+	}
+
+	testCases := []struct {
+		laptopIndex  int
+		score        float64
+		wantCount    uint32
+		wantAvgScore float64
+	}{
+		{laptopIndex: 0, score: 8, wantCount: 1, wantAvgScore: 8},
+		{laptopIndex: 1, score: 6, wantCount: 1, wantAvgScore: 6},
+		{laptopIndex: 0, score: 4, wantCount: 2, wantAvgScore: 6},
+		{laptopIndex: 1, score: 10, wantCount: 2, wantAvgScore: 8},
+		{laptopIndex: 0, score: 9, wantCount: 3, wantAvgScore: 7},
+	}
+
+	stream, err := laptopClient.RateLaptop(context.Background())
+	require.NoError(t, err)
+
+	for _, tc := range testCases {
+		req := &pb.RateLaptopRequest{
+			LaptopId: laptopIDs[tc.laptopIndex],
+			Score:    tc.score,
+		}
+		require.NoError(t, stream.Send(req))
+
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, laptopIDs[tc.laptopIndex], res.GetLaptopId())
+		require.Equal(t, tc.wantCount, res.GetRatedCount())
+		require.Equal(t, tc.wantAvgScore, res.GetAverageScore())
+	}
+
+	err = stream.CloseSend()
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestClientRateLaptopUnknownID(t *testing.T) {
+	t.Parallel()
+
+	store := service.NewInMemoryLaptopStore()
+	laptopServer := service.NewLaptopServer(store, service.NewInMemoryImageStore(), service.NewInMemoryRatingStore())
+	serverAddress := serveLaptopServer(t, laptopServer)
+	laptopClient := newTestLaptopClient(t, serverAddress)
+
+	stream, err := laptopClient.RateLaptop(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(&pb.RateLaptopRequest{LaptopId: "does-not-exist", Score: 5}))
+	require.NoError(t, stream.CloseSend())
+
+	_, err = stream.Recv()
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"pcbook/pb"
+	"pcbook/sample"
+	"pcbook/service"
+)
+
+// seedSearchableLaptops saves n laptops that all satisfy an empty filter (no constraints) and
+// returns their IDs.
+func seedSearchableLaptops(t *testing.T, store service.LaptopStore, n int) map[string]bool {
+	ids := make(map[string]bool, n)
+	for i := 0; i < n; i++ {
+		laptop := sample.NewLaptop()
+		require.NoError(t, store.Save(laptop))
+		ids[laptop.GetId()] = true
+	}
+	return ids
+}
+
+func TestClientSearchLaptopPagination(t *testing.T) {
+	t.Parallel()
+
+	store := service.NewInMemoryLaptopStore()
+	expectedIDs := seedSearchableLaptops(t, store, 25)
+
+	_, serverAddress := startTestLaptopServer(t, store)
+	laptopClient := newTestLaptopClient(t, serverAddress)
+
+	filter := &pb.Filter{MaxPriceUsd: 1 << 30}
+	const pageSize = 7
+
+	seen := make(map[string]bool)
+	pageToken := ""
+	pages := 0
+
+	for {
+		pages++
+		require.LessOrEqual(t, pages, len(expectedIDs)+2, "too many pages, pagination likely looping")
+
+		stream, err := laptopClient.SearchLaptop(context.Background(), &pb.SearchLaptopRequest{
+			Filter:    filter,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		})
+		require.NoError(t, err)
+
+		var nextPageToken string
+		pageCount := 0
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+
+			if res.GetLaptop() == nil {
+				nextPageToken = res.GetNextPageToken()
+				continue
+			}
+
+			id := res.GetLaptop().GetId()
+			require.Contains(t, expectedIDs, id)
+			require.False(t, seen[id], "laptop %s was returned more than once across pages", id)
+			seen[id] = true
+			pageCount++
+		}
+
+		require.LessOrEqual(t, pageCount, pageSize)
+
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	require.Len(t, seen, len(expectedIDs))
+}
+
+func TestClientSearchLaptopCancelMidStream(t *testing.T) {
+	t.Parallel()
+
+	store := service.NewInMemoryLaptopStore()
+	seedSearchableLaptops(t, store, 50)
+
+	_, serverAddress := startTestLaptopServer(t, store)
+	laptopClient := newTestLaptopClient(t, serverAddress)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := laptopClient.SearchLaptop(ctx, &pb.SearchLaptopRequest{
+		Filter:   &pb.Filter{MaxPriceUsd: 1 << 30},
+		PageSize: 50,
+	})
+	require.NoError(t, err)
+
+	// receive a handful of results, then cancel before the stream would naturally finish
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	cancel()
+
+	// drain until the stream reports the cancellation instead of completing normally
+	for {
+		_, err = stream.Recv()
+		if err != nil {
+			break
+		}
+	}
+	require.Error(t, err)
+
+	// give the server-side handler goroutine a moment to observe ctx.Err() and return; if it
+	// were ignoring cancellation it would keep streaming the remaining 49 laptops regardless
+	time.Sleep(50 * time.Millisecond)
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"pcbook/pb"
+	"pcbook/pkg/auth"
+)
+
+// maxImageSize is the largest laptop image the server will accept, in bytes
+const maxImageSize = 1 << 20 * 10 // 10 MiB
+
+// LaptopServer is the server that provides laptop services
+type LaptopServer struct {
+	pb.UnimplementedLaptopServiceServer
+	Store       LaptopStore
+	ImageStore  ImageStore
+	RatingStore RatingStore
+}
+
+// NewLaptopServer returns a new LaptopServer
+func NewLaptopServer(store LaptopStore, imageStore ImageStore, ratingStore RatingStore) *LaptopServer {
+	return &LaptopServer{Store: store, ImageStore: imageStore, RatingStore: ratingStore}
+}
+
+// NewLaptopServerTLS builds a gRPC server with the LaptopService backed by store already
+// registered on it, secured with tlsCreds (see pkg/auth.LoadServerTLSCredentials) and with
+// every RPC checked against interceptor's role map before it reaches the handler. The caller
+// only needs to Serve a listener with the returned server.
+func NewLaptopServerTLS(store LaptopStore, tlsCreds credentials.TransportCredentials, interceptor *auth.AuthInterceptor) *grpc.Server {
+	laptopServer := NewLaptopServer(store, NewInMemoryImageStore(), NewInMemoryRatingStore())
+
+	grpcServer := grpc.NewServer(
+		grpc.Creds(tlsCreds),
+		grpc.UnaryInterceptor(interceptor.Unary()),
+		grpc.StreamInterceptor(interceptor.Stream()),
+	)
+	pb.RegisterLaptopServiceServer(grpcServer, laptopServer)
+
+	return grpcServer
+}
+
+// CreateLaptop is a unary RPC to create a new laptop
+func (server *LaptopServer) CreateLaptop(ctx context.Context, req *pb.CreateLaptopRequest) (*pb.CreateLaptopResponse, error) {
+	laptop := req.GetLaptop()
+	log.Printf("receive a create-laptop request with id: %s", laptop.GetId())
+
+	if len(laptop.GetId()) > 0 {
+		if _, err := uuid.Parse(laptop.GetId()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "laptop ID is not a valid UUID: %v", err)
+		}
+	} else {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot generate a new laptop ID: %v", err)
+		}
+		laptop.Id = id.String()
+	}
+
+	if err := ctx.Err(); err != nil {
+		log.Print("context is cancelled")
+		return nil, status.Error(codes.Canceled, "request is cancelled")
+	}
+
+	if err := server.Store.Save(laptop); err != nil {
+		code := codes.Internal
+		if err == ErrAlreadyExists {
+			code = codes.AlreadyExists
+		}
+		return nil, status.Errorf(code, "cannot save laptop to the store: %v", err)
+	}
+
+	log.Printf("saved laptop with id: %s", laptop.Id)
+	return &pb.CreateLaptopResponse{Id: laptop.Id}, nil
+}
+
+// errPageFull is an internal sentinel returned by the Search found callback once a page's
+// worth of matches has been streamed, so the store stops iterating without treating it as a
+// real failure.
+var errPageFull = errors.New("page is full")
+
+// SearchLaptop is a server-streaming RPC to search for laptops matching a filter. Results are
+// paginated: at most page_size laptops are streamed before a terminal response carrying
+// next_page_token, which the caller passes back as page_token to fetch the following page.
+func (server *LaptopServer) SearchLaptop(req *pb.SearchLaptopRequest, stream pb.LaptopService_SearchLaptopServer) error {
+	filter := req.GetFilter()
+	log.Printf("receive a search-laptop request with filter: %v", filter)
+
+	wantFilterHash := filterHash(filter)
+
+	token, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	if token.LastID != "" && token.FilterHash != wantFilterHash {
+		return status.Error(codes.InvalidArgument, "page token was issued for a different filter")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	var count uint32
+	var lastID string
+
+	err = server.Store.Search(stream.Context(), filter, token.LastID, func(laptop *pb.Laptop) error {
+		if count >= pageSize {
+			return errPageFull
+		}
+
+		if err := stream.Send(&pb.SearchLaptopResponse{Laptop: laptop}); err != nil {
+			return err
+		}
+
+		log.Printf("sent laptop with id: %s", laptop.GetId())
+		count++
+		lastID = laptop.GetId()
+		return nil
+	})
+
+	var nextPageToken string
+	if err == errPageFull {
+		nextPageToken = encodePageToken(lastID, wantFilterHash)
+		err = nil
+	}
+	if err != nil {
+		switch err {
+		case context.Canceled:
+			return status.Error(codes.Canceled, "request is cancelled")
+		case context.DeadlineExceeded:
+			return status.Error(codes.DeadlineExceeded, "deadline is exceeded")
+		default:
+			return status.Errorf(codes.Internal, "unexpected error: %v", err)
+		}
+	}
+
+	return stream.Send(&pb.SearchLaptopResponse{NextPageToken: nextPageToken})
+}
+
+// UploadImage is a client-streaming RPC to upload a laptop image in chunks.
+// The first request must carry ImageInfo; every request after that carries a chunk of data.
+func (server *LaptopServer) UploadImage(stream pb.LaptopService_UploadImageServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return logError(status.Errorf(codes.Unknown, "cannot receive image info: %v", err))
+	}
+
+	info := req.GetInfo()
+	if info == nil {
+		return logError(status.Error(codes.InvalidArgument, "first request must contain image info"))
+	}
+
+	laptop, err := server.Store.Find(info.GetLaptopId())
+	if err != nil {
+		return logError(status.Errorf(codes.Internal, "cannot find laptop: %v", err))
+	}
+	if laptop == nil {
+		return logError(status.Errorf(codes.InvalidArgument, "laptop %s does not exist", info.GetLaptopId()))
+	}
+
+	if info.GetTotalSize() > maxImageSize {
+		return logError(status.Errorf(codes.InvalidArgument, "image size %d exceeds maximum size %d", info.GetTotalSize(), maxImageSize))
+	}
+
+	imageID := info.GetResumeImageId()
+	var received uint64
+	if imageID != "" {
+		received, err = server.ImageStore.BytesReceived(imageID)
+		if err != nil {
+			return logError(status.Errorf(codes.NotFound, "cannot resume unknown image %s: %v", imageID, err))
+		}
+	} else {
+		imageID, err = server.ImageStore.Create(info.GetLaptopId(), info.GetImageType(), info.GetTotalSize(), info.GetSha256())
+		if err != nil {
+			return logError(status.Errorf(codes.Internal, "cannot start image upload: %v", err))
+		}
+	}
+	log.Printf("receiving image with id: %s, resuming from offset: %d", imageID, received)
+
+	for {
+		if err := contextError(stream.Context()); err != nil {
+			return err
+		}
+
+		req, err := stream.Recv()
+		if err == io.EOF {
+			log.Print("no more data")
+			break
+		}
+		if err != nil {
+			return logError(status.Errorf(codes.Unknown, "cannot receive chunk data: %v", err))
+		}
+
+		chunk := req.GetChunkData()
+		size := received + uint64(len(chunk))
+		if size > info.GetTotalSize() {
+			return logError(status.Errorf(codes.InvalidArgument, "image is larger than advertised size %d", info.GetTotalSize()))
+		}
+
+		received, err = server.ImageStore.Write(imageID, received, chunk)
+		if err != nil {
+			return logError(status.Errorf(codes.Internal, "cannot write chunk data: %v", err))
+		}
+	}
+
+	if err := server.ImageStore.Complete(imageID); err != nil {
+		return logError(status.Errorf(codes.DataLoss, "cannot complete image upload: %v", err))
+	}
+
+	res := &pb.UploadImageResponse{Id: imageID, Size: received}
+	if err := stream.SendAndClose(res); err != nil {
+		return logError(status.Errorf(codes.Unknown, "cannot send response: %v", err))
+	}
+
+	log.Printf("saved image with id: %s, size: %d", imageID, received)
+	return nil
+}
+
+// ResumeUpload reports how many bytes of a previously started image upload have been
+// received so far, so a client that lost its connection can continue from that offset.
+func (server *LaptopServer) ResumeUpload(ctx context.Context, req *pb.ResumeUploadRequest) (*pb.ResumeUploadResponse, error) {
+	received, err := server.ImageStore.BytesReceived(req.GetImageId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find image %s: %v", req.GetImageId(), err)
+	}
+
+	return &pb.ResumeUploadResponse{ImageId: req.GetImageId(), BytesReceived: received}, nil
+}
+
+// RateLaptop is a bidirectional-streaming RPC that allows a client to rate many laptops over a
+// single connection, replying to each request with the laptop's running rating count and average.
+func (server *LaptopServer) RateLaptop(stream pb.LaptopService_RateLaptopServer) error {
+	for {
+		if err := contextError(stream.Context()); err != nil {
+			return err
+		}
+
+		req, err := stream.Recv()
+		if err == io.EOF {
+			log.Print("no more data")
+			return nil
+		}
+		if err != nil {
+			return logError(status.Errorf(codes.Unknown, "cannot receive stream request: %v", err))
+		}
+
+		laptopID := req.GetLaptopId()
+		laptop, err := server.Store.Find(laptopID)
+		if err != nil {
+			return logError(status.Errorf(codes.Internal, "cannot find laptop: %v", err))
+		}
+		if laptop == nil {
+			return logError(status.Errorf(codes.NotFound, "laptop %s does not exist", laptopID))
+		}
+
+		count, average, err := server.RatingStore.Add(laptopID, req.GetScore())
+		if err != nil {
+			return logError(status.Errorf(codes.Internal, "cannot add rating to the store: %v", err))
+		}
+
+		res := &pb.RateLaptopResponse{
+			LaptopId:     laptopID,
+			RatedCount:   count,
+			AverageScore: average,
+		}
+
+		if err := stream.Send(res); err != nil {
+			return logError(status.Errorf(codes.Unknown, "cannot send stream response: %v", err))
+		}
+	}
+}
+
+func contextError(ctx context.Context) error {
+	switch ctx.Err() {
+	case context.Canceled:
+		return logError(status.Error(codes.Canceled, "request is cancelled"))
+	case context.DeadlineExceeded:
+		return logError(status.Error(codes.DeadlineExceeded, "deadline is exceeded"))
+	default:
+		return nil
+	}
+}
+
+func logError(err error) error {
+	if err != nil {
+		log.Print(err)
+	}
+	return err
+}
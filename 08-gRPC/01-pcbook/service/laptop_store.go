@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"pcbook/pb"
+)
+
+// ErrAlreadyExists is returned when the laptop ID already exists in the store
+var ErrAlreadyExists = errors.New("record already exists")
+
+// LaptopStore is an interface to store laptops
+type LaptopStore interface {
+	// Save saves the laptop to the store
+	Save(laptop *pb.Laptop) error
+	// Find finds a laptop by ID
+	Find(id string) (*pb.Laptop, error)
+	// Search searches for laptops with filter whose ID sorts strictly after afterID (empty
+	// means from the beginning), in ascending ID order, returning each match one by one via
+	// found. It must stop and return ctx.Err() as soon as ctx is cancelled.
+	Search(ctx context.Context, filter *pb.Filter, afterID string, found func(laptop *pb.Laptop) error) error
+}
+
+// InMemoryLaptopStore stores laptops in memory
+type InMemoryLaptopStore struct {
+	mutex sync.RWMutex
+	data  map[string]*pb.Laptop
+	// ids is data's keys kept sorted so Search can binary-search its way to afterID instead of
+	// scanning the whole map
+	ids []string
+}
+
+// NewInMemoryLaptopStore returns a new InMemoryLaptopStore
+func NewInMemoryLaptopStore() *InMemoryLaptopStore {
+	return &InMemoryLaptopStore{
+		data: make(map[string]*pb.Laptop),
+	}
+}
+
+// Save saves the laptop to the store
+func (store *InMemoryLaptopStore) Save(laptop *pb.Laptop) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if _, ok := store.data[laptop.Id]; ok {
+		return ErrAlreadyExists
+	}
+
+	other := deepCopy(laptop)
+	store.data[other.Id] = other
+
+	pos := sort.SearchStrings(store.ids, other.Id)
+	store.ids = append(store.ids, "")
+	copy(store.ids[pos+1:], store.ids[pos:])
+	store.ids[pos] = other.Id
+
+	return nil
+}
+
+// Find finds a laptop by ID
+func (store *InMemoryLaptopStore) Find(id string) (*pb.Laptop, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	laptop := store.data[id]
+	if laptop == nil {
+		return nil, nil
+	}
+
+	return deepCopy(laptop), nil
+}
+
+// Search searches for laptops with filter, returns one by one via the found function, in
+// ascending ID order starting right after afterID
+func (store *InMemoryLaptopStore) Search(ctx context.Context, filter *pb.Filter, afterID string, found func(laptop *pb.Laptop) error) error {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	start := sort.SearchStrings(store.ids, afterID)
+	if start < len(store.ids) && store.ids[start] == afterID {
+		start++
+	}
+
+	for _, id := range store.ids[start:] {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		laptop := store.data[id]
+		if !isQualified(filter, laptop) {
+			continue
+		}
+
+		if err := found(deepCopy(laptop)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isQualified(filter *pb.Filter, laptop *pb.Laptop) bool {
+	if laptop.GetPriceUsd() > filter.GetMaxPriceUsd() {
+		return false
+	}
+
+	if laptop.GetCpu().GetNumberCores() < filter.GetMinCpuCores() {
+		return false
+	}
+
+	if laptop.GetCpu().GetMinGhz() < filter.GetMinCpuGhz() {
+		return false
+	}
+
+	if toBit(laptop.GetRam()) < toBit(filter.GetMinRam()) {
+		return false
+	}
+
+	return true
+}
+
+func toBit(memory *pb.Memory) uint64 {
+	value := memory.GetValue()
+
+	switch memory.GetUnit() {
+	case pb.Memory_BIT:
+		return value
+	case pb.Memory_BYTE:
+		return value << 3
+	case pb.Memory_KILOBYTE:
+		return value << 13
+	case pb.Memory_MEGABYTE:
+		return value << 23
+	case pb.Memory_GIGABYTE:
+		return value << 33
+	case pb.Memory_TERABYTE:
+		return value << 43
+	default:
+		return 0
+	}
+}
+
+// deepCopy returns a deep copy of a laptop so callers can't mutate store state through
+// a returned pointer.
+func deepCopy(laptop *pb.Laptop) *pb.Laptop {
+	return proto.Clone(laptop).(*pb.Laptop)
+}
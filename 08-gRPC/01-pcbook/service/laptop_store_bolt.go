@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	bolt "go.etcd.io/bbolt"
+
+	"pcbook/pb"
+)
+
+// laptopBucket is the bbolt bucket that holds protobuf-encoded laptops keyed by their UUID
+var laptopBucket = []byte("laptops")
+
+// BoltLaptopStore stores laptops in a BoltDB file on disk
+type BoltLaptopStore struct {
+	db *bolt.DB
+}
+
+// NewBoltLaptopStore opens (creating if necessary) a BoltDB file at dbPath and returns a
+// BoltLaptopStore backed by it
+func NewBoltLaptopStore(dbPath string) (*BoltLaptopStore, error) {
+	db, err := bolt.Open(dbPath, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open bolt db: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(laptopBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create laptops bucket: %w", err)
+	}
+
+	return &BoltLaptopStore{db: db}, nil
+}
+
+// Close closes the underlying BoltDB file
+func (store *BoltLaptopStore) Close() error {
+	return store.db.Close()
+}
+
+// Save saves the laptop to the store
+func (store *BoltLaptopStore) Save(laptop *pb.Laptop) error {
+	return store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(laptopBucket)
+
+		if bucket.Get([]byte(laptop.GetId())) != nil {
+			return ErrAlreadyExists
+		}
+
+		data, err := proto.Marshal(laptop)
+		if err != nil {
+			return fmt.Errorf("cannot marshal laptop: %w", err)
+		}
+
+		return bucket.Put([]byte(laptop.GetId()), data)
+	})
+}
+
+// Find finds a laptop by ID
+func (store *BoltLaptopStore) Find(id string) (*pb.Laptop, error) {
+	var laptop *pb.Laptop
+
+	err := store.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(laptopBucket).Get([]byte(id))
+		if data == nil {
+			return nil
+		}
+
+		laptop = &pb.Laptop{}
+		return proto.Unmarshal(data, laptop)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarshal laptop: %w", err)
+	}
+
+	return laptop, nil
+}
+
+// Search searches for laptops with filter, returns one by one via the found function, in
+// ascending ID order starting right after afterID. Bucket keys are laptop IDs stored in their
+// natural byte order, so Cursor.Seek locates afterID's position in O(log N).
+func (store *BoltLaptopStore) Search(ctx context.Context, filter *pb.Filter, afterID string, found func(laptop *pb.Laptop) error) error {
+	return store.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(laptopBucket).Cursor()
+
+		var key, data []byte
+		if afterID == "" {
+			key, data = cursor.First()
+		} else {
+			key, data = cursor.Seek([]byte(afterID))
+			if key != nil && string(key) == afterID {
+				key, data = cursor.Next()
+			}
+		}
+
+		for ; key != nil; key, data = cursor.Next() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			laptop := &pb.Laptop{}
+			if err := proto.Unmarshal(data, laptop); err != nil {
+				return fmt.Errorf("cannot unmarshal laptop: %w", err)
+			}
+
+			if !isQualified(filter, laptop) {
+				continue
+			}
+
+			if err := found(laptop); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
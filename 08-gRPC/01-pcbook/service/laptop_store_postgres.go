@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"pcbook/pb"
+	"pcbook/serializer"
+)
+
+// PostgresLaptopStore stores laptops in Postgres. The full laptop is kept as JSONB so the
+// schema doesn't need to track every nested field, while the columns used by Search filters
+// are also extracted into indexed columns so searching doesn't require a full table scan.
+type PostgresLaptopStore struct {
+	db *sql.DB
+}
+
+// NewPostgresLaptopStore returns a new PostgresLaptopStore backed by db. The caller owns db
+// and is responsible for closing it.
+func NewPostgresLaptopStore(db *sql.DB) *PostgresLaptopStore {
+	return &PostgresLaptopStore{db: db}
+}
+
+// Migrate creates the laptops table and its indexes if they don't already exist
+func (store *PostgresLaptopStore) Migrate(ctx context.Context) error {
+	_, err := store.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS laptops (
+			id uuid PRIMARY KEY,
+			data jsonb NOT NULL,
+			price_usd double precision NOT NULL,
+			cpu_cores integer NOT NULL,
+			min_cpu_ghz double precision NOT NULL,
+			ram_bits bigint NOT NULL
+		);
+		CREATE INDEX IF NOT EXISTS laptops_price_usd_idx ON laptops (price_usd);
+		CREATE INDEX IF NOT EXISTS laptops_cpu_cores_idx ON laptops (cpu_cores);
+		CREATE INDEX IF NOT EXISTS laptops_min_cpu_ghz_idx ON laptops (min_cpu_ghz);
+		CREATE INDEX IF NOT EXISTS laptops_ram_bits_idx ON laptops (ram_bits);
+	`)
+	if err != nil {
+		return fmt.Errorf("cannot migrate laptops table: %w", err)
+	}
+
+	return nil
+}
+
+// Save saves the laptop to the store
+func (store *PostgresLaptopStore) Save(laptop *pb.Laptop) error {
+	data, err := serializer.ProtobufToJSON(laptop)
+	if err != nil {
+		return fmt.Errorf("cannot marshal laptop to JSON: %w", err)
+	}
+
+	_, err = store.db.Exec(
+		`INSERT INTO laptops (id, data, price_usd, cpu_cores, min_cpu_ghz, ram_bits)
+		 VALUES ($1, $2, $3, $4, $5, $6)`,
+		laptop.GetId(),
+		data,
+		laptop.GetPriceUsd(),
+		laptop.GetCpu().GetNumberCores(),
+		laptop.GetCpu().GetMinGhz(),
+		toBit(laptop.GetRam()),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrAlreadyExists
+		}
+		return fmt.Errorf("cannot insert laptop: %w", err)
+	}
+
+	return nil
+}
+
+// Find finds a laptop by ID
+func (store *PostgresLaptopStore) Find(id string) (*pb.Laptop, error) {
+	var data string
+
+	row := store.db.QueryRow(`SELECT data FROM laptops WHERE id = $1`, id)
+	if err := row.Scan(&data); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot query laptop: %w", err)
+	}
+
+	laptop := &pb.Laptop{}
+	if err := serializer.JSONToProtobuf(data, laptop); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal laptop: %w", err)
+	}
+
+	return laptop, nil
+}
+
+// Search searches for laptops with filter, returns one by one via the found function, in
+// ascending ID order starting right after afterID. The filter and the afterID cursor are both
+// pushed down to SQL, so matching candidates are selected using the indexed columns (including
+// the primary key) instead of being scanned and filtered in Go.
+func (store *PostgresLaptopStore) Search(ctx context.Context, filter *pb.Filter, afterID string, found func(laptop *pb.Laptop) error) error {
+	rows, err := store.db.QueryContext(
+		ctx,
+		`SELECT data FROM laptops
+		 WHERE price_usd <= $1 AND cpu_cores >= $2 AND min_cpu_ghz >= $3 AND ram_bits >= $4 AND id::text > $5
+		 ORDER BY id::text`,
+		filter.GetMaxPriceUsd(),
+		filter.GetMinCpuCores(),
+		filter.GetMinCpuGhz(),
+		toBit(filter.GetMinRam()),
+		afterID,
+	)
+	if err != nil {
+		return fmt.Errorf("cannot query laptops: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return fmt.Errorf("cannot scan laptop row: %w", err)
+		}
+
+		laptop := &pb.Laptop{}
+		if err := serializer.JSONToProtobuf(data, laptop); err != nil {
+			return fmt.Errorf("cannot unmarshal laptop: %w", err)
+		}
+
+		if err := found(laptop); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// isUniqueViolation reports whether err is a Postgres unique-constraint violation (SQLSTATE 23505)
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
@@ -0,0 +1,146 @@
+package service_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"pcbook/pb"
+	"pcbook/sample"
+	"pcbook/service"
+)
+
+func TestClientUploadImage(t *testing.T) {
+	t.Parallel()
+
+	testImageFolder := "../tmp"
+	require.NoError(t, os.MkdirAll(testImageFolder, 0755))
+
+	store := service.NewInMemoryLaptopStore()
+	laptop := sample.NewLaptop()
+	require.NoError(t, store.Save(laptop))
+
+	imageStore := service.NewDiskImageStore(testImageFolder)
+	laptopServer := service.NewLaptopServer(store, imageStore, service.NewInMemoryRatingStore())
+	serverAddress := serveLaptopServer(t, laptopServer)
+	laptopClient := newTestLaptopClient(t, serverAddress)
+
+	imagePath := filepath.Join(testImageFolder, "laptop.png")
+	content := make([]byte, 3<<20+123) // not a multiple of the chunk size
+	for i := range content {
+		content[i] = byte(i)
+	}
+	require.NoError(t, os.WriteFile(imagePath, content, 0644))
+	defer os.Remove(imagePath)
+
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	file, err := os.Open(imagePath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	stream, err := laptopClient.UploadImage(context.Background())
+	require.NoError(t, err)
+
+	err = stream.Send(&pb.UploadImageRequest{
+		Data: &pb.UploadImageRequest_Info{
+			Info: &pb.ImageInfo{
+				LaptopId:  laptop.GetId(),
+				ImageType: filepath.Ext(imagePath),
+				TotalSize: uint64(len(content)),
+				Sha256:    checksum,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	reader := bufio.NewReader(file)
+	buffer := make([]byte, 1024)
+	size := 0
+
+	for {
+		n, err := reader.Read(buffer)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		size += n
+
+		err = stream.Send(&pb.UploadImageRequest{
+			Data: &pb.UploadImageRequest_ChunkData{ChunkData: buffer[:n]},
+		})
+		require.NoError(t, err)
+	}
+
+	res, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	require.NotZero(t, res.GetId())
+	require.EqualValues(t, size, res.GetSize())
+
+	savedContent, err := os.ReadFile(filepath.Join(testImageFolder, res.GetId()))
+	require.NoError(t, err)
+	require.Equal(t, content, savedContent)
+	require.NoError(t, os.Remove(filepath.Join(testImageFolder, res.GetId())))
+}
+
+func TestClientResumeUpload(t *testing.T) {
+	t.Parallel()
+
+	store := service.NewInMemoryLaptopStore()
+	laptop := sample.NewLaptop()
+	require.NoError(t, store.Save(laptop))
+
+	imageStore := service.NewInMemoryImageStore()
+	laptopServer := service.NewLaptopServer(store, imageStore, service.NewInMemoryRatingStore())
+	serverAddress := serveLaptopServer(t, laptopServer)
+	laptopClient := newTestLaptopClient(t, serverAddress)
+
+	content := []byte("pretend this is image data")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	// simulate a connection lost after the first half of the image was written
+	imageID, err := imageStore.Create(laptop.GetId(), ".png", uint64(len(content)), checksum)
+	require.NoError(t, err)
+	half := len(content) / 2
+	_, err = imageStore.Write(imageID, 0, content[:half])
+	require.NoError(t, err)
+
+	resumeRes, err := laptopClient.ResumeUpload(context.Background(), &pb.ResumeUploadRequest{ImageId: imageID})
+	require.NoError(t, err)
+	require.EqualValues(t, half, resumeRes.GetBytesReceived())
+
+	stream, err := laptopClient.UploadImage(context.Background())
+	require.NoError(t, err)
+
+	err = stream.Send(&pb.UploadImageRequest{
+		Data: &pb.UploadImageRequest_Info{
+			Info: &pb.ImageInfo{
+				LaptopId:      laptop.GetId(),
+				ImageType:     ".png",
+				TotalSize:     uint64(len(content)),
+				Sha256:        checksum,
+				ResumeImageId: imageID,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = stream.Send(&pb.UploadImageRequest{
+		Data: &pb.UploadImageRequest_ChunkData{ChunkData: content[half:]},
+	})
+	require.NoError(t, err)
+
+	res, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+	require.Equal(t, imageID, res.GetId())
+	require.EqualValues(t, len(content), res.GetSize())
+}
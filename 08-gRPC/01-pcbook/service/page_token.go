@@ -0,0 +1,58 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"pcbook/pb"
+)
+
+// defaultSearchPageSize is used when a SearchLaptopRequest doesn't specify page_size
+const defaultSearchPageSize = 20
+
+// maxSearchPageSize caps how many laptops a single SearchLaptop response page may contain
+const maxSearchPageSize = 100
+
+// pageToken is the decoded form of the opaque, base64-encoded page_token/next_page_token
+// string: the ID of the last laptop streamed on the previous page, plus a hash of the filter
+// that produced it so a token can't silently be reused against a different search.
+type pageToken struct {
+	LastID     string `json:"last_id"`
+	FilterHash string `json:"filter_hash"`
+}
+
+// encodePageToken base64-encodes a pageToken for use as next_page_token
+func encodePageToken(lastID, filterHash string) string {
+	data, _ := json.Marshal(pageToken{LastID: lastID, FilterHash: filterHash})
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+// decodePageToken reverses encodePageToken. An empty token decodes to the zero value, meaning
+// "start from the beginning".
+func decodePageToken(token string) (pageToken, error) {
+	if token == "" {
+		return pageToken{}, nil
+	}
+
+	data, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return pageToken{}, fmt.Errorf("cannot decode page token: %w", err)
+	}
+
+	var t pageToken
+	if err := json.Unmarshal(data, &t); err != nil {
+		return pageToken{}, fmt.Errorf("cannot parse page token: %w", err)
+	}
+
+	return t, nil
+}
+
+// filterHash returns a short hash identifying filter, so a page token minted for one filter
+// is rejected if replayed against a different one.
+func filterHash(filter *pb.Filter) string {
+	sum := sha256.Sum256([]byte(filter.String()))
+	return hex.EncodeToString(sum[:])[:16]
+}
@@ -0,0 +1,47 @@
+package service
+
+import "sync"
+
+// RatingStore is an interface to store laptop ratings
+type RatingStore interface {
+	// Add adds a new score for laptopID and returns the rated count and the new running average
+	Add(laptopID string, score float64) (count uint32, average float64, err error)
+}
+
+// rating holds the running count and mean for one laptop
+type rating struct {
+	count uint32
+	mean  float64
+}
+
+// InMemoryRatingStore stores laptop ratings in memory
+type InMemoryRatingStore struct {
+	mutex sync.RWMutex
+	data  map[string]*rating
+}
+
+// NewInMemoryRatingStore returns a new InMemoryRatingStore
+func NewInMemoryRatingStore() *InMemoryRatingStore {
+	return &InMemoryRatingStore{
+		data: make(map[string]*rating),
+	}
+}
+
+// Add adds a new score for laptopID and returns the rated count and the new running average.
+// The average is updated incrementally (avg_n = avg_{n-1} + (score - avg_{n-1})/n) so it stays
+// numerically stable over long-running streams instead of accumulating a growing sum.
+func (store *InMemoryRatingStore) Add(laptopID string, score float64) (uint32, float64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	r := store.data[laptopID]
+	if r == nil {
+		r = &rating{}
+		store.data[laptopID] = r
+	}
+
+	r.count++
+	r.mean += (score - r.mean) / float64(r.count)
+
+	return r.count, r.mean, nil
+}